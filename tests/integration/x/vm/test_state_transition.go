@@ -512,7 +512,7 @@ func (s *KeeperTestSuite) TestRefundGas() {
 				unitNetwork.GetContext(),
 				*coreMsg,
 				refund,
-				unitNetwork.GetBaseDenom(),
+				unitNetwork.GetEVMDenom(),
 			)
 
 			if tc.noError {
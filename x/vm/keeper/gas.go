@@ -40,10 +40,19 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
+//
+// The leftover gas is priced with the 18 decimals gas price, so the refund must be denominated in the
+// EVM coin denom. The bank wrapper then scales it to the extended denom, which keeps refunds exact on
+// chains where the EVM coin does not use 18 decimals.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) (err error) {
 	ctx, span := ctx.StartSpan(tracer, "RefundGas", trace.WithAttributes(attribute.Int64("leftover_gas", int64(leftoverGas)))) //nolint:gosec // G115
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
+	// Only the EVM coin can be converted from its 18 decimals representation by the bank wrapper.
+	if evmDenom := types.GetEVMCoinDenom(); denom != evmDenom {
+		return errorsmod.Wrapf(types.ErrInvalidRefund, "refund denom %s does not match the evm denom %s", denom, evmDenom)
+	}
+
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
 
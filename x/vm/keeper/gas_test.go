@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/mock"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestRefundGas() {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	eighteenDecimalsCoinInfo := testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]
+	sixDecimalsCoinInfo := testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID]
+
+	testCases := []struct {
+		name        string
+		coinInfo    vmtypes.EvmCoinInfo
+		denom       string
+		leftoverGas uint64
+		gasPrice    *big.Int
+		expRefund   sdk.Coins
+		expErr      error
+	}{
+		{
+			name:        "pass - 18 decimals refund",
+			coinInfo:    eighteenDecimalsCoinInfo,
+			denom:       eighteenDecimalsCoinInfo.Denom,
+			leftoverGas: 21_000,
+			gasPrice:    big.NewInt(1e9),
+			expRefund:   sdk.NewCoins(sdk.NewCoin(eighteenDecimalsCoinInfo.ExtendedDenom, sdkmath.NewInt(21_000*1e9))),
+		},
+		{
+			name:        "pass - 6 decimals refund is sent in the extended denom",
+			coinInfo:    sixDecimalsCoinInfo,
+			denom:       sixDecimalsCoinInfo.Denom,
+			leftoverGas: 21_000,
+			gasPrice:    big.NewInt(1e9),
+			expRefund:   sdk.NewCoins(sdk.NewCoin(sixDecimalsCoinInfo.ExtendedDenom, sdkmath.NewInt(21_000*1e9))),
+		},
+		{
+			name:        "pass - 6 decimals refund below the conversion factor is kept exact",
+			coinInfo:    sixDecimalsCoinInfo,
+			denom:       sixDecimalsCoinInfo.Denom,
+			leftoverGas: 3,
+			gasPrice:    big.NewInt(7),
+			expRefund:   sdk.NewCoins(sdk.NewCoin(sixDecimalsCoinInfo.ExtendedDenom, sdkmath.NewInt(21))),
+		},
+		{
+			name:        "pass - no leftover gas",
+			coinInfo:    sixDecimalsCoinInfo,
+			denom:       sixDecimalsCoinInfo.Denom,
+			leftoverGas: 0,
+			gasPrice:    big.NewInt(1e9),
+		},
+		{
+			name:        "fail - 6 decimals refund in the extended denom",
+			coinInfo:    sixDecimalsCoinInfo,
+			denom:       sixDecimalsCoinInfo.ExtendedDenom,
+			leftoverGas: 21_000,
+			gasPrice:    big.NewInt(1e9),
+			expErr:      vmtypes.ErrInvalidRefund,
+		},
+		{
+			name:        "fail - refund in a non evm denom",
+			coinInfo:    eighteenDecimalsCoinInfo,
+			denom:       "uatom",
+			leftoverGas: 21_000,
+			gasPrice:    big.NewInt(1e9),
+			expErr:      vmtypes.ErrInvalidRefund,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			configurator := vmtypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			suite.Require().NoError(configurator.WithEVMCoinInfo(tc.coinInfo).Configure())
+
+			if !tc.expRefund.Empty() {
+				suite.bankKeeper.On(
+					"SendCoinsFromModuleToAccount",
+					mock.Anything,
+					authtypes.FeeCollectorName,
+					sdk.AccAddress(sender.Bytes()),
+					tc.expRefund,
+				).Return(nil).Once()
+			}
+
+			msg := core.Message{From: sender, GasPrice: tc.gasPrice}
+			err := suite.vmKeeper.RefundGas(suite.ctx, msg, tc.leftoverGas, tc.denom)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.bankKeeper.AssertExpectations(suite.T())
+		})
+	}
+}